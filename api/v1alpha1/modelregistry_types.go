@@ -0,0 +1,135 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ModelRegistrySpec defines the desired state of ModelRegistry
+type ModelRegistrySpec struct {
+	// ManifestsRef points at a ConfigMap, keyed by template file name (e.g.
+	// "deployment.yaml.tmpl"), holding an override set of manifest templates
+	// to render in place of the operator's embedded bundle.
+	// +optional
+	ManifestsRef *corev1.LocalObjectReference `json:"manifestsRef,omitempty"`
+
+	// Overlays are applied, in order, as strategic-merge patches on top of
+	// the rendered base manifest of the resource(s) they target, letting a
+	// cluster admin patch container images, resource requests, envs, or add
+	// sidecars without forking the operator. An overlay that changes the
+	// resource kind, owner references, or a controller-managed label is
+	// rejected.
+	// +optional
+	Overlays []Overlay `json:"overlays,omitempty"`
+}
+
+// Overlay is a single patch applied on top of the rendered manifest of one
+// managed resource.
+type Overlay struct {
+	// Kind of the managed resource this overlay targets, e.g. "Deployment",
+	// "Service", or "ServiceAccount". Required, since the same field (say,
+	// metadata.annotations) exists on every managed resource and would
+	// otherwise be patched everywhere it's found instead of just where it
+	// was intended.
+	Kind string `json:"kind"`
+	// Name optionally restricts this overlay to the managed resource named
+	// Name; left empty, it applies to every managed resource of Kind.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Patch is a strategic-merge patch document, as JSON or YAML.
+	Patch string `json:"patch"`
+}
+
+// ManagedResourceStatus summarizes the observed state of a single resource
+// owned by a ModelRegistry, so ModelRegistryStatus can report the full
+// rollout state of every child object in one place.
+type ManagedResourceStatus struct {
+	// Name of the managed resource.
+	Name string `json:"name"`
+	// Kind of the managed resource.
+	Kind string `json:"kind"`
+	// APIVersion of the managed resource.
+	APIVersion string `json:"apiVersion"`
+	// ObservedGeneration is the generation of the managed resource that was
+	// last observed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// ReadyReplicas is the number of ready replicas, for resources that have
+	// replicas (e.g. Deployment).
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// AvailableReplicas is the number of available replicas, for resources
+	// that have replicas (e.g. Deployment).
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+	// Ready reports whether this resource is considered up and serving.
+	Ready bool `json:"ready"`
+	// Reason is a short machine-readable explanation for the current Ready
+	// value.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message is a human-readable detail, e.g. a pod-level failure reason,
+	// for the current Ready value.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ModelRegistryStatus defines the observed state of ModelRegistry
+type ModelRegistryStatus struct {
+	// Conditions describe the current state of the ModelRegistry reconcile.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Components lists the observed state of every resource owned by this
+	// ModelRegistry (ServiceAccount, Service, Deployment, ...), so that
+	// `kubectl get modelregistry -o yaml` shows the full rollout state
+	// without a separate get per child kind.
+	// +optional
+	Components []ManagedResourceStatus `json:"components,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ModelRegistry is the Schema for the modelregistries API
+type ModelRegistry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ModelRegistrySpec   `json:"spec,omitempty"`
+	Status ModelRegistryStatus `json:"status,omitempty"`
+}
+
+// Default applies default values to fields the user left unset, mirroring
+// what the defaulting webhook applies when EnableWebhooks is set.
+func (r *ModelRegistry) Default() {
+}
+
+// +kubebuilder:object:root=true
+
+// ModelRegistryList contains a list of ModelRegistry
+type ModelRegistryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ModelRegistry `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ModelRegistry{}, &ModelRegistryList{})
+}
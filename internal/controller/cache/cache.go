@@ -0,0 +1,109 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cache lets a controller remember the hash of the last rendered
+// manifest it successfully applied for a managed object, so a reconcile
+// that renders the exact same object again can skip the diff-and-patch path
+// entirely instead of re-computing a three-way merge on every pass.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type key struct {
+	namespace string
+	name      string
+	goType    string
+}
+
+type entry struct {
+	hash            string
+	resourceVersion string
+}
+
+// ResourceHashCache is a per-object hash cache keyed by (namespace/name,
+// kind). It's safe for concurrent use by multiple reconciles.
+type ResourceHashCache struct {
+	mu      sync.Mutex
+	entries map[key]entry
+}
+
+// New returns an empty ResourceHashCache.
+func New() *ResourceHashCache {
+	return &ResourceHashCache{entries: make(map[key]entry)}
+}
+
+// keyFor identifies obj by its concrete Go type rather than
+// obj.GetObjectKind().GroupVersionKind(): TypeMeta is routinely left empty
+// by the typed client on Get/List/Watch (see the "envtest is missing
+// typemeta" hack in createOrUpdate), so two lookups for the very same
+// object can otherwise land on different GVKs depending on where the
+// object came from.
+func keyFor(obj client.Object) key {
+	return key{
+		namespace: obj.GetNamespace(),
+		name:      obj.GetName(),
+		goType:    fmt.Sprintf("%T", obj),
+	}
+}
+
+// Hash computes the SHA-256 of obj's JSON encoding, used both to remember
+// what was last applied and to check whether a newly rendered object
+// matches it.
+func Hash(obj client.Object) (string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Unchanged reports whether curr (the object as last observed, e.g. via a
+// Get) matches the cached hash for this object and has the same
+// resourceVersion the cache last saw - i.e. nothing has changed on either
+// the desired or the observed side since the last successful apply.
+func (c *ResourceHashCache) Unchanged(curr client.Object, hash string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[keyFor(curr)]
+	return ok && e.hash == hash && e.resourceVersion == curr.GetResourceVersion()
+}
+
+// Remember records hash as the last-applied hash for obj, alongside
+// obj's resourceVersion as observed right after the write that produced it.
+func (c *ResourceHashCache) Remember(obj client.Object, hash string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[keyFor(obj)] = entry{hash: hash, resourceVersion: obj.GetResourceVersion()}
+}
+
+// Invalidate drops any cached hash for obj, forcing the next reconcile to
+// recompute and re-diff it. Call this from watch events on the owned
+// object; those also fire for the controller's own writes, so an entry
+// remembered after a write is dropped again once its event arrives.
+func (c *ResourceHashCache) Invalidate(obj client.Object) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, keyFor(obj))
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conditions centralizes the ModelRegistry status condition
+// vocabulary so that clients get a stable contract instead of having to
+// infer rollout state from a single Phase-style enum: every condition this
+// operator reports is one of the Type constants below, and every write goes
+// through Set so ObservedGeneration is never forgotten.
+package conditions
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Type enumerates the condition types this operator writes to
+// ModelRegistry.Status.Conditions. A typo in a condition type is a compile
+// error here, rather than a silently-never-matched string a client filters
+// on at runtime.
+type Type string
+
+const (
+	// ReconcileSuccess is True once every step of Reconcile completed
+	// without error and every managed resource is Ready, for
+	// ObservedGeneration; it is False while any managed resource isn't.
+	ReconcileSuccess Type = "ReconcileSuccess"
+	// TemplateRendered is True once the manifest templates rendered into
+	// valid child objects for ObservedGeneration.
+	TemplateRendered Type = "TemplateRendered"
+	// DatabaseReachable is True when the registry's configured database
+	// connection has been verified reachable. Reported Unknown until that
+	// check is wired up for a given spec.
+	DatabaseReachable Type = "DatabaseReachable"
+	// DeploymentAvailable is True when every managed resource reported in
+	// Status.Components, the owned Deployment included, is Ready, so clients
+	// don't need to fetch the Deployment separately. Its message carries the
+	// first non-ready resource's reason (e.g. a pod's ImagePullBackOff).
+	DeploymentAvailable Type = "DeploymentAvailable"
+	// IstioConfigured is True when Istio integration was requested in the
+	// spec and its resources were successfully rendered, and False when it
+	// wasn't requested at all.
+	IstioConfigured Type = "IstioConfigured"
+)
+
+// Set writes a condition of the given Type, stamping ObservedGeneration so
+// clients can tell whether the reported condition reflects the current
+// spec or a stale one from before the latest change.
+func Set(conditions *[]metav1.Condition, generation int64, conditionType Type, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               string(conditionType),
+		Status:             status,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
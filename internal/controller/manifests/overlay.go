@@ -0,0 +1,120 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	modelregistryv1alpha1 "github.com/opendatahub-io/model-registry-operator/api/v1alpha1"
+)
+
+// protectedLabels are the controller-managed labels an overlay can't change.
+// Managed resources are looked up by name in createOrUpdate and matched by
+// owner reference in the watches in SetupWithManager, not by these labels.
+var protectedLabels = []string{
+	"app.kubernetes.io/managed-by",
+	"app.kubernetes.io/part-of",
+}
+
+// ApplyOverlays strategic-merge-patches every overlay in overlays that
+// targets kind (and, if set, matches object's name) onto object (a pointer
+// to a typed API object), in order, so a cluster admin can patch container
+// images, resource requests, envs, service annotations, and extra sidecars
+// without forking the operator. kind is passed in explicitly, rather than
+// read off object's TypeMeta, since the rendered object's TypeMeta isn't
+// reliably populated at this point in the reconcile (see the "envtest is
+// missing typemeta" hack in createOrUpdate). Any overlay that would change
+// the object's kind, owner references, or controller-managed labels is
+// rejected before it's applied.
+func ApplyOverlays(object client.Object, kind string, overlays []modelregistryv1alpha1.Overlay) error {
+	targeted := make([]modelregistryv1alpha1.Overlay, 0, len(overlays))
+	for _, overlay := range overlays {
+		if overlay.Kind == kind && (overlay.Name == "" || overlay.Name == object.GetName()) {
+			targeted = append(targeted, overlay)
+		}
+	}
+	if len(targeted) == 0 {
+		return nil
+	}
+
+	original, err := json.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("error marshalling %T for overlay: %w", object, err)
+	}
+
+	current := original
+	for i, overlay := range targeted {
+		patchJSON, err := yaml.ToJSON([]byte(overlay.Patch))
+		if err != nil {
+			return fmt.Errorf("overlay %d rejected: invalid overlay patch: %w", i, err)
+		}
+		if err := validateOverlay(patchJSON); err != nil {
+			return fmt.Errorf("overlay %d rejected: %w", i, err)
+		}
+		patched, err := strategicpatch.StrategicMergePatch(current, patchJSON, object)
+		if err != nil {
+			return fmt.Errorf("error applying overlay %d to %T: %w", i, object, err)
+		}
+		current = patched
+	}
+
+	if err := json.Unmarshal(current, object); err != nil {
+		return fmt.Errorf("error unmarshalling patched %T: %w", object, err)
+	}
+
+	if object.GetOwnerReferences() == nil {
+		return fmt.Errorf("overlay cleared owner references")
+	}
+	return nil
+}
+
+// validateOverlay rejects a raw patch document outright, before it's ever
+// merged in, if it touches a field no overlay should be allowed to change:
+// the resource kind, owner references, or a controller-managed label.
+func validateOverlay(patch []byte) error {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(patch, &fields); err != nil {
+		return fmt.Errorf("invalid overlay patch: %w", err)
+	}
+
+	if _, ok := fields["kind"]; ok {
+		return fmt.Errorf("overlay must not change kind")
+	}
+	if _, ok := fields["apiVersion"]; ok {
+		return fmt.Errorf("overlay must not change apiVersion")
+	}
+
+	metadata, _ := fields["metadata"].(map[string]interface{})
+	if metadata == nil {
+		return nil
+	}
+	if _, ok := metadata["ownerReferences"]; ok {
+		return fmt.Errorf("overlay must not change ownerReferences")
+	}
+	labels, _ := metadata["labels"].(map[string]interface{})
+	for _, protected := range protectedLabels {
+		if _, ok := labels[protected]; ok {
+			return fmt.Errorf("overlay must not change controller-managed label %q", protected)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package manifests decouples the reconciler from where its child-object
+// templates come from. A Source renders a named template with a set of
+// params into a typed object; EmbeddedSource, ConfigMapSource and
+// DirectorySource give three ways to supply that template.
+package manifests
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Source renders templateName with params and unmarshals the result into
+// object.
+type Source interface {
+	Render(ctx context.Context, templateName string, params interface{}, object interface{}) error
+}
+
+func executeAndUnmarshal(tmpl *template.Template, templateName string, params interface{}, object interface{}) error {
+	builder := strings.Builder{}
+	if err := tmpl.ExecuteTemplate(&builder, templateName, params); err != nil {
+		return fmt.Errorf("error parsing templates %w", err)
+	}
+	if err := yaml.Unmarshal([]byte(builder.String()), object); err != nil {
+		return fmt.Errorf("error unmarshalling rendered template %s: %w", templateName, err)
+	}
+	return nil
+}
+
+// EmbeddedSource renders the operator's built-in, embedded template bundle.
+// This is the default Source used in production.
+type EmbeddedSource struct {
+	Template *template.Template
+}
+
+func (s *EmbeddedSource) Render(_ context.Context, templateName string, params interface{}, object interface{}) error {
+	return executeAndUnmarshal(s.Template, templateName, params, object)
+}
+
+// ConfigMapSource renders templates out of a ConfigMap's Data, keyed by
+// template name, so a cluster admin can override the operator's built-in
+// manifests via ModelRegistrySpec.ManifestsRef without forking the operator.
+type ConfigMapSource struct {
+	Client    client.Client
+	Name      string
+	Namespace string
+}
+
+func (s *ConfigMapSource) Render(ctx context.Context, templateName string, params interface{}, object interface{}) error {
+	cm := &corev1.ConfigMap{}
+	if err := s.Client.Get(ctx, client.ObjectKey{Name: s.Name, Namespace: s.Namespace}, cm); err != nil {
+		return fmt.Errorf("error reading manifests ConfigMap %s/%s: %w", s.Namespace, s.Name, err)
+	}
+	raw, ok := cm.Data[templateName]
+	if !ok {
+		return fmt.Errorf("manifests ConfigMap %s/%s has no entry for template %s", s.Namespace, s.Name, templateName)
+	}
+	tmpl, err := template.New(templateName).Parse(raw)
+	if err != nil {
+		return fmt.Errorf("error parsing template %s from ConfigMap %s/%s: %w", templateName, s.Namespace, s.Name, err)
+	}
+	return executeAndUnmarshal(tmpl, templateName, params, object)
+}
+
+// DirectorySource renders *.tmpl files from a directory on disk, for local
+// development where iterating on a template shouldn't require rebuilding
+// the operator image or round-tripping through a ConfigMap.
+type DirectorySource struct {
+	Dir string
+}
+
+func (s *DirectorySource) Render(_ context.Context, templateName string, params interface{}, object interface{}) error {
+	path := filepath.Join(s.Dir, templateName)
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading template file %s: %w", path, err)
+	}
+	tmpl, err := template.New(templateName).Parse(string(raw))
+	if err != nil {
+		return fmt.Errorf("error parsing template file %s: %w", path, err)
+	}
+	return executeAndUnmarshal(tmpl, templateName, params, object)
+}
@@ -27,18 +27,34 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	klog "sigs.k8s.io/controller-runtime/pkg/log"
-	"strings"
-	"text/template"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
 
 	modelregistryv1alpha1 "github.com/opendatahub-io/model-registry-operator/api/v1alpha1"
+	"github.com/opendatahub-io/model-registry-operator/internal/controller/cache"
+	"github.com/opendatahub-io/model-registry-operator/internal/controller/conditions"
+	"github.com/opendatahub-io/model-registry-operator/internal/controller/manifests"
+	"github.com/opendatahub-io/model-registry-operator/util/finalizers"
 )
 
+// fieldManager is the stable field manager used for server-side apply on
+// every managed resource, so concurrent edits by other actors (e.g. an
+// admin hand-editing an annotation) are merged instead of clobbered by the
+// old last-applied-config annotation dance.
+const fieldManager = "model-registry-operator"
+
 const modelRegistryFinalizer = "modelregistry.opendatahub.io/finalizer"
 
 // Definitions to manage status conditions
@@ -57,14 +73,78 @@ const (
 	ReasonUnavailable = "DeploymentUnavailable"
 )
 
+// DefaultConflictBackoff is used by retryOnConflict when
+// ModelRegistryReconciler.ConflictBackoff is left at its zero value.
+var DefaultConflictBackoff = wait.Backoff{
+	Steps:    5,
+	Duration: 10 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+	Cap:      time.Second,
+}
+
 // ModelRegistryReconciler reconciles a ModelRegistry object
 type ModelRegistryReconciler struct {
 	client.Client
-	Scheme         *runtime.Scheme
-	Recorder       record.EventRecorder
-	Log            logr.Logger
-	Template       *template.Template
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Log      logr.Logger
+	// Source renders the built-in manifest templates. Overridden per
+	// reconcile when ModelRegistrySpec.ManifestsRef points at a ConfigMap.
+	Source         manifests.Source
 	EnableWebhooks bool
+	// ConflictBackoff tunes retryOnConflict's retries for status and
+	// finalizer updates. Left at its zero value, DefaultConflictBackoff is
+	// used instead.
+	ConflictBackoff wait.Backoff
+	// ResourceCache remembers the hash of the last rendered manifest applied
+	// for each managed object, so createOrUpdate can skip re-diffing one
+	// that hasn't changed. Lazily initialized if left nil.
+	ResourceCache *cache.ResourceHashCache
+	cacheMu       sync.Mutex
+}
+
+// conflictBackoff returns the configured backoff, or DefaultConflictBackoff
+// if the reconciler wasn't given one.
+func (r *ModelRegistryReconciler) conflictBackoff() wait.Backoff {
+	if r.ConflictBackoff.Steps == 0 {
+		return DefaultConflictBackoff
+	}
+	return r.ConflictBackoff
+}
+
+// resourceCache lazily initializes ResourceCache so reconcilers built
+// without one (e.g. in existing test/main wiring) still work.
+func (r *ModelRegistryReconciler) resourceCache() *cache.ResourceHashCache {
+	r.cacheMu.Lock()
+	defer r.cacheMu.Unlock()
+	if r.ResourceCache == nil {
+		r.ResourceCache = cache.New()
+	}
+	return r.ResourceCache
+}
+
+// retryOnConflict re-Gets modelRegistry and re-applies mutate before
+// retrying on a conflict error, modeled on client-go's
+// retry.RetryOnConflict. Use it around any status or finalizer mutation so
+// that concurrent actors (webhooks, other controllers, kubectl edit) updating
+// the same ModelRegistry don't turn into a failed, noisily requeued
+// reconcile.
+func (r *ModelRegistryReconciler) retryOnConflict(ctx context.Context, key client.ObjectKey, modelRegistry *modelregistryv1alpha1.ModelRegistry, mutate func(*modelregistryv1alpha1.ModelRegistry) error, write func(*modelregistryv1alpha1.ModelRegistry) error) error {
+	return retry.OnError(r.conflictBackoff(), errors.IsConflict, func() error {
+		if err := mutate(modelRegistry); err != nil {
+			return err
+		}
+		if err := write(modelRegistry); err != nil {
+			if errors.IsConflict(err) {
+				if getErr := r.Get(ctx, key, modelRegistry); getErr != nil {
+					return getErr
+				}
+			}
+			return err
+		}
+		return nil
+	})
 }
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -93,81 +173,26 @@ func (r *ModelRegistryReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, err
 	}
 
-	// Let's add a finalizer. Then, we can define some operations which should
-	// occurs before the custom resource to be deleted.
+	// Ensure the finalizer before doing anything else, including before
+	// Default(). This must run first so that a webhook-set default can
+	// never race with the finalizer-add write: we requeue as soon as the
+	// finalizer is added and let the cache observe that update before we
+	// build any child objects on the next reconcile.
 	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers
-	if !controllerutil.ContainsFinalizer(modelRegistry, modelRegistryFinalizer) {
-		log.Info("Adding Finalizer for ModelRegistry")
-		if ok := controllerutil.AddFinalizer(modelRegistry, modelRegistryFinalizer); !ok {
-			log.Error(err, "Failed to add finalizer into the custom resource")
-			return ctrl.Result{Requeue: true}, nil
-		}
-
-		if err = r.Update(ctx, modelRegistry); err != nil {
-			log.Error(err, "Failed to update custom resource to add finalizer")
-			return ctrl.Result{}, err
-		}
+	finalizerAdded, err := finalizers.EnsureFinalizer(ctx, r.Client, r.conflictBackoff(), modelRegistry, modelRegistryFinalizer)
+	if err != nil {
+		log.Error(err, "Failed to update custom resource to add finalizer")
+		return ctrl.Result{}, err
+	}
+	if finalizerAdded {
+		log.Info("Added Finalizer for ModelRegistry")
+		return ctrl.Result{Requeue: true}, nil
 	}
 
-	// Check if the modelRegistry instance is marked to be deleted, which is
-	// indicated by the deletion timestamp being set.
-	isMarkedToBeDeleted := modelRegistry.GetDeletionTimestamp() != nil
-	if isMarkedToBeDeleted {
-		if controllerutil.ContainsFinalizer(modelRegistry, modelRegistryFinalizer) {
-			log.Info("Performing Finalizer Operations for modelRegistry before delete CR")
-
-			// Let's add here an status "Degraded" to define that this resource begin its process to be terminated.
-			meta.SetStatusCondition(&modelRegistry.Status.Conditions, metav1.Condition{Type: ConditionTypeDegraded,
-				Status: metav1.ConditionUnknown, Reason: "Finalizing",
-				Message: fmt.Sprintf("Performing finalizer operations for the custom resource: %s ", modelRegistry.Name)})
-
-			if err = r.Status().Update(ctx, modelRegistry); IgnoreDeletingErrors(err) != nil {
-				switch t := err.(type) {
-				case *errors.StatusError:
-					log.Error(err, "status error", "status", t.Status())
-				}
-				log.Error(err, "Failed to update modelRegistry status")
-				return ctrl.Result{}, err
-			}
-
-			// Perform all operations required before remove the finalizer and allow
-			// the Kubernetes API to remove the custom resource.
-			r.doFinalizerOperationsForModelRegistry(modelRegistry)
-
-			// TODO(user): If you add operations to the doFinalizerOperationsForModelRegistry method
-			// then you need to ensure that all worked fine before deleting and updating the Downgrade status
-			// otherwise, you should requeue here.
-
-			// Re-fetch the modelRegistry Custom Resource before update the status
-			// so that we have the latest state of the resource on the cluster and we will avoid
-			// raise the issue "the object has been modified, please apply
-			// your changes to the latest version and try again" which would re-trigger the reconciliation
-			if err = r.Get(ctx, req.NamespacedName, modelRegistry); IgnoreDeletingErrors(err) != nil {
-				log.Error(err, "Failed to re-fetch modelRegistry")
-				return ctrl.Result{}, err
-			}
-
-			meta.SetStatusCondition(&modelRegistry.Status.Conditions, metav1.Condition{Type: ConditionTypeDegraded,
-				Status: metav1.ConditionTrue, Reason: "Finalizing",
-				Message: fmt.Sprintf("Finalizer operations for custom resource %s were successfully accomplished", modelRegistry.Name)})
-
-			if err = r.Status().Update(ctx, modelRegistry); IgnoreDeletingErrors(err) != nil {
-				log.Error(err, "Failed to update modelRegistry status")
-				return ctrl.Result{}, err
-			}
-
-			log.Info("Removing Finalizer for modelRegistry after successfully perform the operations")
-			if ok := controllerutil.RemoveFinalizer(modelRegistry, modelRegistryFinalizer); !ok {
-				log.Error(err, "Failed to remove finalizer for modelRegistry")
-				return ctrl.Result{Requeue: true}, nil
-			}
-
-			if err = r.Update(ctx, modelRegistry); IgnoreDeletingErrors(err) != nil {
-				log.Error(err, "Failed to remove finalizer for modelRegistry")
-				return ctrl.Result{}, err
-			}
-		}
-		return ctrl.Result{}, nil
+	if handled, err := finalizers.HandleDeletion(ctx, r.Client, r.conflictBackoff(), modelRegistry, modelRegistryFinalizer, func() error {
+		return r.finalizeModelRegistry(ctx, req, modelRegistry)
+	}); handled {
+		return ctrl.Result{}, err
 	}
 
 	// set defaults if not using webhooks
@@ -214,15 +239,57 @@ func IgnoreDeletingErrors(err error) error {
 }
 
 // SetupWithManager sets up the controller with the Manager.
+//
+// Each managed kind is watched explicitly, rather than relying solely on
+// Owns, with a predicate that keeps only objects owned by a ModelRegistry.
+// A new kind emitted by future templates (ConfigMap, Ingress, Job,
+// StatefulSet, ...) needs both a watch here and its own read in
+// collectManagedResourceStatus, which only reads the kinds it names.
 func (r *ModelRegistryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	predicates := ctrlbuilder.WithPredicates(isOwnedByModelRegistry(), r.invalidateCacheOnChange())
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&modelregistryv1alpha1.ModelRegistry{}).
-		Owns(&corev1.Service{}).
-		Owns(&corev1.ServiceAccount{}).
-		Owns(&appsv1.Deployment{}).
+		Watches(&corev1.ServiceAccount{}, handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &modelregistryv1alpha1.ModelRegistry{}), predicates).
+		Watches(&corev1.Service{}, handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &modelregistryv1alpha1.ModelRegistry{}), predicates).
+		Watches(&appsv1.Deployment{}, handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &modelregistryv1alpha1.ModelRegistry{}), predicates).
 		Complete(r)
 }
 
+// isOwnedByModelRegistry filters watch events down to objects whose owner
+// references point at a ModelRegistry, so that reconciles are only enqueued
+// for resources this controller actually manages.
+func isOwnedByModelRegistry() predicate.Predicate {
+	owned := func(obj client.Object) bool {
+		for _, ref := range obj.GetOwnerReferences() {
+			if ref.Kind == "ModelRegistry" && ref.APIVersion == modelregistryv1alpha1.GroupVersion.String() {
+				return true
+			}
+		}
+		return false
+	}
+	return predicate.NewPredicateFuncs(owned)
+}
+
+// invalidateCacheOnChange evicts the resource hash cache entry for an owned
+// object whenever the informer observes it change or disappear. This
+// includes the update event for createOrUpdate's own patch, so the reconcile
+// after a patch re-diffs the object once before the cache can skip it again.
+// It never filters out an event; it only has the side effect of keeping the
+// cache honest.
+func (r *ModelRegistryReconciler) invalidateCacheOnChange() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			r.resourceCache().Invalidate(e.ObjectNew)
+			return true
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			r.resourceCache().Invalidate(e.Object)
+			return true
+		},
+	}
+}
+
 //+kubebuilder:rbac:groups=modelregistry.opendatahub.io,resources=modelregistries,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=modelregistry.opendatahub.io,resources=modelregistries/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=modelregistry.opendatahub.io,resources=modelregistries/finalizers,verbs=update
@@ -230,6 +297,7 @@ func (r *ModelRegistryReconciler) SetupWithManager(mgr ctrl.Manager) error {
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=services;serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
 
 func (r *ModelRegistryReconciler) updateRegistryResources(ctx context.Context, params *ModelRegistryParams, registry *modelregistryv1alpha1.ModelRegistry) (OperationResult, error) {
 	var result, result2, result3 OperationResult
@@ -262,77 +330,264 @@ func (r *ModelRegistryReconciler) updateRegistryResources(ctx context.Context, p
 func (r *ModelRegistryReconciler) setRegistryStatus(ctx context.Context, req ctrl.Request, operationResult OperationResult) error {
 	log := klog.FromContext(ctx)
 
+	// collect a substatus for every managed resource so that
+	// `kubectl get modelregistry -o yaml` shows the full rollout state in one
+	// place, instead of requiring a separate get per child kind.
+	components, err := r.collectManagedResourceStatus(ctx, req.NamespacedName)
+	if err != nil {
+		log.Error(err, "Failed to collect managed resource status", "name", req.NamespacedName)
+		return err
+	}
+	available, unavailableMessage := allComponentsReady(components)
+
 	modelRegistry := &modelregistryv1alpha1.ModelRegistry{}
 	if err := r.Get(ctx, req.NamespacedName, modelRegistry); err != nil {
 		log.Error(err, "Failed to re-fetch modelRegistry")
 		return err
 	}
 
-	status := metav1.ConditionTrue
-	reason := ReasonCreated
-	message := "Deployment for custom resource %s was successfully created"
-	switch operationResult {
-	case ResourceCreated:
-		status = metav1.ConditionFalse
-		reason = ReasonCreating
-		message = "Creating deployment for custom resource %s"
-	case ResourceUpdated:
-		status = metav1.ConditionFalse
-		reason = ReasonUpdating
-		message = "Updating deployment for custom resource %s"
+	if err := r.retryOnConflict(ctx, req.NamespacedName, modelRegistry,
+		func(mr *modelregistryv1alpha1.ModelRegistry) error {
+			status := metav1.ConditionTrue
+			reason := ReasonCreated
+			message := "Deployment for custom resource %s was successfully created"
+			switch operationResult {
+			case ResourceCreated:
+				status = metav1.ConditionFalse
+				reason = ReasonCreating
+				message = "Creating deployment for custom resource %s"
+			case ResourceUpdated:
+				status = metav1.ConditionFalse
+				reason = ReasonUpdating
+				message = "Updating deployment for custom resource %s"
+			}
+			meta.SetStatusCondition(&mr.Status.Conditions, metav1.Condition{Type: ConditionTypeProgressing,
+				Status: status, Reason: reason,
+				Message: fmt.Sprintf(message, mr.Name)})
+
+			mr.Status.Components = components
+
+			// TemplateRendered is true by the time we get here: Reconcile
+			// already rendered and applied every child object successfully,
+			// or it would have returned before calling setRegistryStatus.
+			conditions.Set(&mr.Status.Conditions, mr.Generation, conditions.TemplateRendered,
+				metav1.ConditionTrue, "Rendered", fmt.Sprintf("Templates for custom resource %s were rendered successfully", mr.Name))
+
+			deploymentStatus := metav1.ConditionFalse
+			deploymentReason := ReasonUnavailable
+			deploymentMessage := "Deployment for custom resource %s is not available"
+			if available {
+				deploymentStatus = metav1.ConditionTrue
+				deploymentReason = ReasonAvailable
+				deploymentMessage = "Deployment for custom resource %s is available"
+			} else if unavailableMessage != "" {
+				deploymentMessage += ": " + unavailableMessage
+			}
+			conditions.Set(&mr.Status.Conditions, mr.Generation, conditions.DeploymentAvailable,
+				deploymentStatus, deploymentReason, fmt.Sprintf(deploymentMessage, mr.Name))
+
+			// DatabaseReachable has no check wired up yet in this reconciler,
+			// so it's reported Unknown rather than silently omitted, which
+			// would otherwise read the same as "condition doesn't exist" to
+			// a client doing a type-by-type status lookup.
+			conditions.Set(&mr.Status.Conditions, mr.Generation, conditions.DatabaseReachable,
+				metav1.ConditionUnknown, "NotImplemented", "Database reachability is not yet verified by this reconciler")
+
+			// IstioConfigured only applies once ModelRegistrySpec grows an
+			// Istio integration field; until then every ModelRegistry simply
+			// didn't request it.
+			conditions.Set(&mr.Status.Conditions, mr.Generation, conditions.IstioConfigured,
+				metav1.ConditionFalse, "NotRequested", "Istio integration was not requested for this custom resource")
+
+			// kept for backwards compatibility with existing clients/dashboards
+			// that still watch the original Available condition type.
+			meta.SetStatusCondition(&mr.Status.Conditions, metav1.Condition{Type: ConditionTypeAvailable,
+				Status: deploymentStatus, Reason: deploymentReason,
+				Message: fmt.Sprintf(deploymentMessage, mr.Name)})
+
+			reconcileStatus := metav1.ConditionTrue
+			reconcileReason := "Succeeded"
+			reconcileMessage := fmt.Sprintf("Reconcile of custom resource %s succeeded", mr.Name)
+			if !available {
+				reconcileStatus = metav1.ConditionFalse
+				reconcileReason = ReasonUnavailable
+				reconcileMessage = fmt.Sprintf("Reconcile of custom resource %s is waiting on managed resources to become ready", mr.Name)
+			}
+			conditions.Set(&mr.Status.Conditions, mr.Generation, conditions.ReconcileSuccess,
+				reconcileStatus, reconcileReason, reconcileMessage)
+
+			return nil
+		},
+		func(mr *modelregistryv1alpha1.ModelRegistry) error {
+			return r.Status().Update(ctx, mr)
+		}); err != nil {
+		log.Error(err, "Failed to update modelRegistry status")
+		return err
 	}
+	return nil
+}
 
-	meta.SetStatusCondition(&modelRegistry.Status.Conditions, metav1.Condition{Type: ConditionTypeProgressing,
-		Status: status, Reason: reason,
-		Message: fmt.Sprintf(message, modelRegistry.Name)})
+// collectManagedResourceStatus reads back every resource kind this
+// controller manages for nsName and summarizes it into the
+// ManagedResourceStatus entries exposed as ModelRegistryStatus.Components. Kinds that have not
+// been created yet (e.g. not rendered by the current template set) are
+// skipped rather than treated as an error.
+func (r *ModelRegistryReconciler) collectManagedResourceStatus(ctx context.Context, nsName client.ObjectKey) ([]modelregistryv1alpha1.ManagedResourceStatus, error) {
+	var components []modelregistryv1alpha1.ManagedResourceStatus
+
+	sa := &corev1.ServiceAccount{}
+	switch err := r.Get(ctx, nsName, sa); {
+	case err == nil:
+		components = append(components, modelregistryv1alpha1.ManagedResourceStatus{
+			Name:               sa.Name,
+			Kind:               sa.Kind,
+			APIVersion:         sa.APIVersion,
+			ObservedGeneration: sa.Generation,
+			Ready:              true,
+			Reason:             "Exists",
+		})
+	case !errors.IsNotFound(err):
+		return nil, err
+	}
+
+	svc := &corev1.Service{}
+	switch err := r.Get(ctx, nsName, svc); {
+	case err == nil:
+		components = append(components, modelregistryv1alpha1.ManagedResourceStatus{
+			Name:               svc.Name,
+			Kind:               svc.Kind,
+			APIVersion:         svc.APIVersion,
+			ObservedGeneration: svc.Generation,
+			Ready:              true,
+			Reason:             "Exists",
+		})
+	case !errors.IsNotFound(err):
+		return nil, err
+	}
 
-	// determine registry available condition
 	deployment := &appsv1.Deployment{}
-	if err := r.Get(ctx, req.NamespacedName, deployment); err != nil {
-		log.Error(err, "Failed to get modelRegistry deployment", "name", req.NamespacedName)
-		return err
+	switch err := r.Get(ctx, nsName, deployment); {
+	case err == nil:
+		deploymentStatus := r.deploymentStatus(ctx, nsName, deployment)
+		components = append(components, deploymentStatus)
+	case !errors.IsNotFound(err):
+		return nil, err
 	}
-	log.V(10).Info("Found service deployment", "name", len(deployment.Name))
 
-	// check deployment availability
+	return components, nil
+}
+
+// deploymentStatus summarizes a Deployment's rollout state, surfacing
+// pod-level failure reasons (ImagePullBackOff, CrashLoopBackOff) in the
+// reason/message when the Deployment condition alone wouldn't explain why
+// it isn't available yet.
+func (r *ModelRegistryReconciler) deploymentStatus(ctx context.Context, nsName client.ObjectKey, deployment *appsv1.Deployment) modelregistryv1alpha1.ManagedResourceStatus {
+	log := klog.FromContext(ctx)
+
 	available := false
+	reason := "Unknown"
+	message := ""
 	for _, c := range deployment.Status.Conditions {
 		if c.Type == appsv1.DeploymentAvailable {
 			available = c.Status == corev1.ConditionTrue
+			reason = c.Reason
+			message = c.Message
 			break
 		}
 	}
 
-	if available {
-		status = metav1.ConditionTrue
-		reason = ReasonAvailable
-		message = "Deployment for custom resource %s is available"
+	if !available {
+		if podReason, podMessage := r.podFailureReason(ctx, deployment); podReason != "" {
+			reason = podReason
+			message = podMessage
+		}
 	} else {
-		status = metav1.ConditionFalse
-		reason = ReasonUnavailable
-		message = "Deployment for custom resource %s is not available"
+		log.V(10).Info("deployment available", "name", nsName)
 	}
-	meta.SetStatusCondition(&modelRegistry.Status.Conditions, metav1.Condition{Type: ConditionTypeAvailable,
-		Status: status, Reason: reason,
-		Message: fmt.Sprintf(message, modelRegistry.Name)})
 
-	if err := r.Status().Update(ctx, modelRegistry); err != nil {
-		log.Error(err, "Failed to update modelRegistry status")
-		return err
+	return modelregistryv1alpha1.ManagedResourceStatus{
+		Name:               deployment.Name,
+		Kind:               deployment.Kind,
+		APIVersion:         deployment.APIVersion,
+		ObservedGeneration: deployment.Generation,
+		ReadyReplicas:      deployment.Status.ReadyReplicas,
+		AvailableReplicas:  deployment.Status.AvailableReplicas,
+		Ready:              available,
+		Reason:             reason,
+		Message:            message,
 	}
-	return nil
+}
+
+// podFailureReason looks for the first pod whose waiting container reports
+// ImagePullBackOff, ErrImagePull or CrashLoopBackOff, so that transient scheduling delays
+// don't get conflated with an actual image or crash problem. It lists pods
+// by the Deployment's own selector rather than guessing a label scheme, so
+// it keeps working regardless of which labels the rendered template puts on
+// the pod.
+func (r *ModelRegistryReconciler) podFailureReason(ctx context.Context, deployment *appsv1.Deployment) (reason string, message string) {
+	log := klog.FromContext(ctx)
+	nsName := client.ObjectKeyFromObject(deployment)
+
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		log.Error(err, "Failed to parse deployment selector", "name", nsName)
+		return "", ""
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(nsName.Namespace),
+		client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		log.Error(err, "Failed to list pods for deployment", "name", nsName)
+		return "", ""
+	}
+
+	for _, pod := range pods.Items {
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.State.Waiting == nil {
+				continue
+			}
+			switch cs.State.Waiting.Reason {
+			case "ImagePullBackOff", "ErrImagePull", "CrashLoopBackOff":
+				return cs.State.Waiting.Reason, fmt.Sprintf("container %s in pod %s: %s",
+					cs.Name, pod.Name, cs.State.Waiting.Message)
+			}
+		}
+	}
+	return "", ""
+}
+
+// allComponentsReady reports whether every managed resource collected by
+// collectManagedResourceStatus is Ready - not just the Deployment - and
+// returns the first non-ready component's reason/message for the condition.
+// A ModelRegistry with no components yet (nothing created) isn't available.
+func allComponentsReady(components []modelregistryv1alpha1.ManagedResourceStatus) (ready bool, message string) {
+	if len(components) == 0 {
+		return false, ""
+	}
+	for _, c := range components {
+		if !c.Ready {
+			if c.Message != "" {
+				return false, fmt.Sprintf("%s (%s)", c.Message, c.Reason)
+			}
+			return false, c.Reason
+		}
+	}
+	return true, ""
 }
 
 func (r *ModelRegistryReconciler) createOrUpdateDeployment(ctx context.Context, params *ModelRegistryParams,
 	registry *modelregistryv1alpha1.ModelRegistry, templateName string) (result OperationResult, err error) {
 	result = ResourceUnchanged
 	var deployment appsv1.Deployment
-	if err = r.Apply(params, templateName, &deployment); err != nil {
+	if err = r.Apply(ctx, params, templateName, registry, &deployment); err != nil {
 		return result, err
 	}
 	if err = ctrl.SetControllerReference(registry, &deployment, r.Scheme); err != nil {
 		return result, err
 	}
+	if err = manifests.ApplyOverlays(&deployment, "Deployment", registry.Spec.Overlays); err != nil {
+		return result, err
+	}
 
 	result, err = r.createOrUpdate(ctx, deployment.DeepCopy(), &deployment)
 	if err != nil {
@@ -345,12 +600,15 @@ func (r *ModelRegistryReconciler) createOrUpdateService(ctx context.Context, par
 	registry *modelregistryv1alpha1.ModelRegistry, templateName string) (result OperationResult, err error) {
 	result = ResourceUnchanged
 	var service corev1.Service
-	if err = r.Apply(params, templateName, &service); err != nil {
+	if err = r.Apply(ctx, params, templateName, registry, &service); err != nil {
 		return result, err
 	}
 	if err = ctrl.SetControllerReference(registry, &service, r.Scheme); err != nil {
 		return result, err
 	}
+	if err = manifests.ApplyOverlays(&service, "Service", registry.Spec.Overlays); err != nil {
+		return result, err
+	}
 	if result, err = r.createOrUpdate(ctx, service.DeepCopy(), &service); err != nil {
 		return result, err
 	}
@@ -361,12 +619,15 @@ func (r *ModelRegistryReconciler) createOrUpdateServiceAccount(ctx context.Conte
 	registry *modelregistryv1alpha1.ModelRegistry, templateName string) (result OperationResult, err error) {
 	result = ResourceUnchanged
 	var sa corev1.ServiceAccount
-	if err = r.Apply(params, templateName, &sa); err != nil {
+	if err = r.Apply(ctx, params, templateName, registry, &sa); err != nil {
 		return result, err
 	}
 	if err = ctrl.SetControllerReference(registry, &sa, r.Scheme); err != nil {
 		return result, err
 	}
+	if err = manifests.ApplyOverlays(&sa, "ServiceAccount", registry.Spec.Overlays); err != nil {
+		return result, err
+	}
 
 	if result, err = r.createOrUpdate(ctx, sa.DeepCopy(), &sa); err != nil {
 		return result, err
@@ -391,9 +652,21 @@ func (r *ModelRegistryReconciler) createOrUpdate(ctx context.Context, currObj cl
 	result := ResourceUnchanged
 
 	key := client.ObjectKeyFromObject(newObj)
-	gvk := newObj.GetObjectKind().GroupVersionKind()
 	name := newObj.GetName()
 
+	// Server-side apply marshals newObj as-is, so it needs a real
+	// apiVersion/kind; the rendered template's TypeMeta is empty.
+	gvk, err := apiutil.GVKForObject(newObj, r.Scheme)
+	if err != nil {
+		return result, err
+	}
+	newObj.GetObjectKind().SetGroupVersionKind(gvk)
+
+	hash, err := cache.Hash(newObj)
+	if err != nil {
+		return result, err
+	}
+
 	if err := r.Client.Get(ctx, key, currObj); err != nil {
 		if client.IgnoreNotFound(err) == nil {
 			// create object
@@ -403,12 +676,26 @@ func (r *ModelRegistryReconciler) createOrUpdate(ctx context.Context, currObj cl
 			if err := patch.DefaultAnnotator.SetLastAppliedAnnotation(newObj); err != nil {
 				return result, err
 			}
-			return result, r.Client.Create(ctx, newObj)
+			if err := r.Client.Create(ctx, newObj); err != nil {
+				return result, err
+			}
+			// Create wrote the server-assigned resourceVersion back into newObj.
+			r.resourceCache().Remember(newObj, hash)
+			return result, nil
 		}
 		// get error
 		return result, err
 	}
 
+	// the rendered object is byte-for-byte identical to the one we last
+	// successfully applied, and nothing else has touched currObj since
+	// (its resourceVersion, as observed from the informer cache, hasn't
+	// moved) - skip the diff-and-patch path entirely.
+	if r.resourceCache().Unchanged(currObj, hash) {
+		log.V(5).Info("skipping unchanged resource", "kind", gvk, "name", name)
+		return result, nil
+	}
+
 	// hack: envtest is missing typemeta for some reason, hence the ignores for apiVersion and kind!!!
 	// create a patch by comparing objects
 	patchResult, err := patch.DefaultPatchMaker.Calculate(currObj, newObj, patch.IgnoreStatusFields(),
@@ -416,20 +703,93 @@ func (r *ModelRegistryReconciler) createOrUpdate(ctx context.Context, currObj cl
 	if err != nil {
 		return result, err
 	}
-	if !patchResult.IsEmpty() {
-		// update object
-		result = ResourceUpdated
-		log.Info("updating", "kind", gvk, "name", name)
-		// update last applied config in annotation
-		if err := patch.DefaultAnnotator.SetLastAppliedAnnotation(newObj); err != nil {
-			return result, err
-		}
-		return result, r.Client.Update(ctx, newObj)
+	if patchResult.IsEmpty() {
+		// currObj already matches what we'd apply: remember it under currObj's
+		// own resourceVersion, since newObj was only ever rendered, never
+		// written, and so never had one assigned.
+		r.resourceCache().Remember(currObj, hash)
+		return result, nil
+	}
+
+	// update object
+	result = ResourceUpdated
+	log.Info("updating", "kind", gvk, "name", name)
+	// server-side apply with a stable field manager so concurrent edits to
+	// annotations/labels by other actors are merged in rather than
+	// clobbered by the last-applied-config annotation dance; unlike the
+	// create path above, newObj isn't annotated with its own last-applied
+	// config here, since SSA doesn't need or use it.
+	if err := r.Client.Patch(ctx, newObj, client.Apply, client.FieldOwner(fieldManager), client.ForceOwnership); err != nil {
+		return result, err
 	}
 
+	// Patch wrote the server-assigned resourceVersion back into newObj.
+	r.resourceCache().Remember(newObj, hash)
 	return result, nil
 }
 
+// finalizeModelRegistry runs the finalizer operations for modelRegistry,
+// moving the Degraded condition from Unknown to True around the work done
+// in doFinalizerOperationsForModelRegistry. finalizers.HandleDeletion calls
+// this before it removes the finalizer.
+func (r *ModelRegistryReconciler) finalizeModelRegistry(ctx context.Context, req ctrl.Request, modelRegistry *modelregistryv1alpha1.ModelRegistry) error {
+	log := klog.FromContext(ctx)
+	log.Info("Performing Finalizer Operations for modelRegistry before delete CR")
+
+	// Let's add here an status "Degraded" to define that this resource begin its process to be terminated.
+	err := r.retryOnConflict(ctx, req.NamespacedName, modelRegistry,
+		func(mr *modelregistryv1alpha1.ModelRegistry) error {
+			meta.SetStatusCondition(&mr.Status.Conditions, metav1.Condition{Type: ConditionTypeDegraded,
+				Status: metav1.ConditionUnknown, Reason: "Finalizing",
+				Message: fmt.Sprintf("Performing finalizer operations for the custom resource: %s ", mr.Name)})
+			return nil
+		},
+		func(mr *modelregistryv1alpha1.ModelRegistry) error {
+			return r.Status().Update(ctx, mr)
+		})
+	if IgnoreDeletingErrors(err) != nil {
+		switch t := err.(type) {
+		case *errors.StatusError:
+			log.Error(err, "status error", "status", t.Status())
+		}
+		log.Error(err, "Failed to update modelRegistry status")
+		return err
+	}
+
+	// Perform all operations required before remove the finalizer and allow
+	// the Kubernetes API to remove the custom resource.
+	r.doFinalizerOperationsForModelRegistry(modelRegistry)
+
+	// TODO(user): If you add operations to the doFinalizerOperationsForModelRegistry method
+	// then you need to ensure that all worked fine before deleting and updating the Downgrade status
+	// otherwise, you should requeue here.
+
+	// Re-fetch the modelRegistry Custom Resource before update the status
+	// so that we have the latest state of the resource on the cluster and we will avoid
+	// raise the issue "the object has been modified, please apply
+	// your changes to the latest version and try again" which would re-trigger the reconciliation
+	if err = r.Get(ctx, req.NamespacedName, modelRegistry); IgnoreDeletingErrors(err) != nil {
+		log.Error(err, "Failed to re-fetch modelRegistry")
+		return err
+	}
+
+	err = r.retryOnConflict(ctx, req.NamespacedName, modelRegistry,
+		func(mr *modelregistryv1alpha1.ModelRegistry) error {
+			meta.SetStatusCondition(&mr.Status.Conditions, metav1.Condition{Type: ConditionTypeDegraded,
+				Status: metav1.ConditionTrue, Reason: "Finalizing",
+				Message: fmt.Sprintf("Finalizer operations for custom resource %s were successfully accomplished", mr.Name)})
+			return nil
+		},
+		func(mr *modelregistryv1alpha1.ModelRegistry) error {
+			return r.Status().Update(ctx, mr)
+		})
+	if IgnoreDeletingErrors(err) != nil {
+		log.Error(err, "Failed to update modelRegistry status")
+		return err
+	}
+	return nil
+}
+
 // finalizeMemcached will perform the required operations before delete the CR.
 func (r *ModelRegistryReconciler) doFinalizerOperationsForModelRegistry(registry *modelregistryv1alpha1.ModelRegistry) {
 	// TODO(user): Add the cleanup steps that the operator
@@ -457,16 +817,20 @@ type ModelRegistryParams struct {
 	Spec      modelregistryv1alpha1.ModelRegistrySpec
 }
 
-// executes given template name with params
-func (r *ModelRegistryReconciler) Apply(params *ModelRegistryParams, templateName string, object interface{}) error {
-	builder := strings.Builder{}
-	err := r.Template.ExecuteTemplate(&builder, templateName, params)
-	if err != nil {
-		return fmt.Errorf("error parsing templates %w", err)
+// Apply renders templateName into object using the registry's manifests
+// Source: the ConfigMap named by Spec.ManifestsRef when set, falling back
+// to r.Source (normally the operator's embedded templates) otherwise.
+func (r *ModelRegistryReconciler) Apply(ctx context.Context, params *ModelRegistryParams, templateName string, registry *modelregistryv1alpha1.ModelRegistry, object client.Object) error {
+	source := r.Source
+	if registry.Spec.ManifestsRef != nil {
+		source = &manifests.ConfigMapSource{
+			Client:    r.Client,
+			Name:      registry.Spec.ManifestsRef.Name,
+			Namespace: params.Namespace,
+		}
 	}
-	err = yaml.Unmarshal([]byte(builder.String()), object)
-	if err != nil {
-		return fmt.Errorf("error creating %T for model registry %s in namespace %s", object, params.Name, params.Namespace)
+	if err := source.Render(ctx, templateName, params, object); err != nil {
+		return fmt.Errorf("error creating %T for model registry %s in namespace %s: %w", object, params.Name, params.Namespace, err)
 	}
 	return nil
 }
@@ -0,0 +1,114 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	modelregistryv1alpha1 "github.com/opendatahub-io/model-registry-operator/api/v1alpha1"
+)
+
+func newFakeReconciler(t *testing.T, mr *modelregistryv1alpha1.ModelRegistry) *ModelRegistryReconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := modelregistryv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to register scheme: %v", err)
+	}
+	return &ModelRegistryReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(mr).WithStatusSubresource(mr).Build(),
+		// keep the test fast: a handful of near-zero-duration steps is enough
+		// to exercise give-up-after-N-conflicts without slowing the suite down.
+		ConflictBackoff: wait.Backoff{Steps: 3, Duration: time.Millisecond},
+	}
+}
+
+func newConflictErr(name string) error {
+	return apierrors.NewConflict(schema.GroupResource{Group: "modelregistry.opendatahub.io", Resource: "modelregistries"}, name, fmt.Errorf("conflict"))
+}
+
+// TestRetryOnConflictRetriesUntilSuccess injects a conflict on the first two
+// writes and expects retryOnConflict to re-apply mutate and succeed on the
+// third.
+func TestRetryOnConflictRetriesUntilSuccess(t *testing.T) {
+	mr := &modelregistryv1alpha1.ModelRegistry{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+	r := newFakeReconciler(t, mr)
+	key := client.ObjectKeyFromObject(mr)
+
+	const failFirst = 2
+	attempts := 0
+	mutateCalls := 0
+
+	err := r.retryOnConflict(context.Background(), key, mr,
+		func(m *modelregistryv1alpha1.ModelRegistry) error {
+			mutateCalls++
+			m.Status.Components = []modelregistryv1alpha1.ManagedResourceStatus{{Name: "svc", Ready: true}}
+			return nil
+		},
+		func(m *modelregistryv1alpha1.ModelRegistry) error {
+			attempts++
+			if attempts <= failFirst {
+				return newConflictErr(m.Name)
+			}
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("expected eventual success, got %v", err)
+	}
+	if attempts != failFirst+1 {
+		t.Fatalf("expected %d write attempts, got %d", failFirst+1, attempts)
+	}
+	if mutateCalls != attempts {
+		t.Fatalf("expected mutate to be re-applied once per attempt (%d), got %d", attempts, mutateCalls)
+	}
+}
+
+// TestRetryOnConflictGivesUpAfterExhaustingBackoff checks that a conflict on
+// every write surfaces as an error once ConflictBackoff's steps are used up,
+// instead of retrying forever.
+func TestRetryOnConflictGivesUpAfterExhaustingBackoff(t *testing.T) {
+	mr := &modelregistryv1alpha1.ModelRegistry{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+	r := newFakeReconciler(t, mr)
+	key := client.ObjectKeyFromObject(mr)
+
+	attempts := 0
+	err := r.retryOnConflict(context.Background(), key, mr,
+		func(m *modelregistryv1alpha1.ModelRegistry) error { return nil },
+		func(m *modelregistryv1alpha1.ModelRegistry) error {
+			attempts++
+			return newConflictErr(m.Name)
+		})
+	if err == nil {
+		t.Fatal("expected an error once the backoff was exhausted, got nil")
+	}
+	if !apierrors.IsConflict(err) {
+		t.Fatalf("expected the surfaced error to still be a conflict, got %v", err)
+	}
+	if attempts != int(r.conflictBackoff().Steps) {
+		t.Fatalf("expected exactly %d write attempts, got %d", r.conflictBackoff().Steps, attempts)
+	}
+}
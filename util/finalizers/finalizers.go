@@ -0,0 +1,94 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package finalizers provides reusable finalizer add/remove helpers for
+// controllers, so that the same race-free sequencing can be shared across
+// CRDs instead of being re-implemented inline in each Reconcile.
+package finalizers
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// EnsureFinalizer adds the named finalizer to obj if it isn't already
+// present, retrying on conflict with backoff. finalizerAdded reports
+// whether the finalizer was just added, so callers can requeue and let the
+// cache observe the update before doing any work that depends on obj (e.g.
+// applying webhook-set defaults or building child objects).
+func EnsureFinalizer(ctx context.Context, c client.Client, backoff wait.Backoff, obj client.Object, name string) (finalizerAdded bool, err error) {
+	if controllerutil.ContainsFinalizer(obj, name) {
+		return false, nil
+	}
+
+	key := client.ObjectKeyFromObject(obj)
+	err = retry.RetryOnConflict(backoff, func() error {
+		controllerutil.AddFinalizer(obj, name)
+		if updateErr := c.Update(ctx, obj); updateErr != nil {
+			if errors.IsConflict(updateErr) {
+				if getErr := c.Get(ctx, key, obj); getErr != nil {
+					return getErr
+				}
+			}
+			return updateErr
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// HandleDeletion runs finalize and removes the named finalizer from obj
+// once finalize succeeds, retrying the finalizer removal on conflict with
+// backoff. handled reports whether obj is marked for deletion: callers
+// should treat it as a terminal branch of Reconcile and return immediately,
+// propagating err. When obj isn't being deleted, or doesn't carry the
+// finalizer, handled is false/true respectively and finalize is never
+// called.
+func HandleDeletion(ctx context.Context, c client.Client, backoff wait.Backoff, obj client.Object, name string, finalize func() error) (handled bool, err error) {
+	if obj.GetDeletionTimestamp() == nil {
+		return false, nil
+	}
+	if !controllerutil.ContainsFinalizer(obj, name) {
+		return true, nil
+	}
+
+	if err = finalize(); err != nil {
+		return true, err
+	}
+
+	key := client.ObjectKeyFromObject(obj)
+	err = retry.RetryOnConflict(backoff, func() error {
+		controllerutil.RemoveFinalizer(obj, name)
+		if updateErr := c.Update(ctx, obj); updateErr != nil {
+			if errors.IsConflict(updateErr) {
+				if getErr := c.Get(ctx, key, obj); getErr != nil {
+					return getErr
+				}
+			}
+			return updateErr
+		}
+		return nil
+	})
+	return true, err
+}